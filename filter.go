@@ -0,0 +1,68 @@
+package slogx
+
+import (
+	"context"
+	"log/slog"
+	"math"
+)
+
+// FilterOption selects the minimum level a Filter lets through. Use one of
+// the Allow* constructors below.
+type FilterOption func() slog.Level
+
+// AllowAll lets every level through, including Trace.
+func AllowAll() FilterOption { return func() slog.Level { return slog.Level(math.MinInt) } }
+
+// AllowDebugAndAbove lets Debug and above through.
+func AllowDebugAndAbove() FilterOption { return func() slog.Level { return slog.LevelDebug } }
+
+// AllowInfoAndAbove lets Info and above through.
+func AllowInfoAndAbove() FilterOption { return func() slog.Level { return slog.LevelInfo } }
+
+// AllowWarnAndAbove lets Warn and above through.
+func AllowWarnAndAbove() FilterOption { return func() slog.Level { return slog.LevelWarn } }
+
+// AllowErrorOnly lets Error and Fatal through.
+func AllowErrorOnly() FilterOption { return func() slog.Level { return slog.LevelError } }
+
+// AllowNone drops every record.
+func AllowNone() FilterOption { return func() slog.Level { return LevelMax } }
+
+// Filter wraps a slog.Handler and drops records below a minimum level,
+// rather than routing them elsewhere the way LevelBasedHandler and
+// RoutingHandler do. Build one with NewFilter.
+type Filter struct {
+	next slog.Handler
+	min  slog.Level
+}
+
+// NewFilter returns a slog.Handler that silently drops records below the
+// level opt selects, forwarding everything else to next. Filters compose
+// with the routing handlers in this package; a common production setup is
+// NewFilter(&LevelBasedHandler{...}, AllowInfoAndAbove()) to keep Trace/Debug
+// chatter out of both inner handlers at once.
+func NewFilter(next slog.Handler, opt FilterOption) slog.Handler {
+	return &Filter{next: next, min: opt()}
+}
+
+func (f *Filter) Enabled(ctx context.Context, level slog.Level) bool {
+	if level < f.min {
+		return false
+	}
+	return f.next.Enabled(ctx, level)
+}
+
+func (f *Filter) Handle(ctx context.Context, r slog.Record) error {
+	if r.Level < f.min {
+		return nil
+	}
+	return f.next.Handle(ctx, r)
+}
+
+func (f *Filter) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &Filter{next: f.next.WithAttrs(attrs), min: f.min}
+}
+
+func (f *Filter) WithGroup(name string) slog.Handler {
+	return &Filter{next: f.next.WithGroup(name), min: f.min}
+}
@@ -0,0 +1,129 @@
+package slogx
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+type discardHandler struct{}
+
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return true }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (h discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return h }
+func (h discardHandler) WithGroup(string) slog.Handler           { return h }
+
+func TestFilter_DropsBelowThreshold(t *testing.T) {
+	tests := []struct {
+		name       string
+		opt        FilterOption
+		logLevel   slog.Level
+		wantPassed bool
+	}{
+		{name: "AllowAll passes trace", opt: AllowAll(), logLevel: LevelTrace, wantPassed: true},
+		{name: "AllowDebugAndAbove drops trace", opt: AllowDebugAndAbove(), logLevel: LevelTrace, wantPassed: false},
+		{name: "AllowDebugAndAbove passes debug", opt: AllowDebugAndAbove(), logLevel: slog.LevelDebug, wantPassed: true},
+		{name: "AllowInfoAndAbove drops debug", opt: AllowInfoAndAbove(), logLevel: slog.LevelDebug, wantPassed: false},
+		{name: "AllowInfoAndAbove passes info", opt: AllowInfoAndAbove(), logLevel: slog.LevelInfo, wantPassed: true},
+		{name: "AllowWarnAndAbove drops info", opt: AllowWarnAndAbove(), logLevel: slog.LevelInfo, wantPassed: false},
+		{name: "AllowWarnAndAbove passes warn", opt: AllowWarnAndAbove(), logLevel: slog.LevelWarn, wantPassed: true},
+		{name: "AllowErrorOnly drops warn", opt: AllowErrorOnly(), logLevel: slog.LevelWarn, wantPassed: false},
+		{name: "AllowErrorOnly passes error", opt: AllowErrorOnly(), logLevel: slog.LevelError, wantPassed: true},
+		{name: "AllowErrorOnly passes fatal", opt: AllowErrorOnly(), logLevel: LevelFatal, wantPassed: true},
+		{name: "AllowNone drops fatal", opt: AllowNone(), logLevel: LevelFatal, wantPassed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := &stubHandler{}
+			f := NewFilter(next, tt.opt)
+
+			ctx := context.Background()
+			if got := f.Enabled(ctx, tt.logLevel); got != tt.wantPassed {
+				t.Errorf("Enabled(%v) = %v, want %v", tt.logLevel, got, tt.wantPassed)
+			}
+
+			rec := slog.NewRecord(time.Now(), tt.logLevel, "msg", 0)
+			if err := f.Handle(ctx, rec); err != nil {
+				t.Fatalf("Handle() error = %v", err)
+			}
+
+			wantRecords := 0
+			if tt.wantPassed {
+				wantRecords = 1
+			}
+			if len(next.records) != wantRecords {
+				t.Errorf("next handler got %d records, want %d", len(next.records), wantRecords)
+			}
+		})
+	}
+}
+
+func TestFilter_WithAttrsAndWithGroup(t *testing.T) {
+	next := &stubHandler{}
+	f := NewFilter(next, AllowInfoAndAbove())
+
+	withAttrs, ok := f.WithAttrs([]slog.Attr{slog.String("k", "v")}).(*Filter)
+	if !ok {
+		t.Fatal("WithAttrs() did not return *Filter")
+	}
+	if withAttrs.min != slog.LevelInfo {
+		t.Errorf("WithAttrs() min = %v, want %v", withAttrs.min, slog.LevelInfo)
+	}
+
+	withGroup, ok := f.WithGroup("g").(*Filter)
+	if !ok {
+		t.Fatal("WithGroup() did not return *Filter")
+	}
+	if withGroup.min != slog.LevelInfo {
+		t.Errorf("WithGroup() min = %v, want %v", withGroup.min, slog.LevelInfo)
+	}
+}
+
+func TestFilter_ComposesWithLevelBasedHandler(t *testing.T) {
+	low := &stubHandler{}
+	errs := &stubHandler{}
+
+	logger := slog.New(NewFilter(&LevelBasedHandler{LowLevelHandler: low, ErrorHandler: errs}, AllowWarnAndAbove()))
+
+	logger.Debug("dropped")
+	logger.Warn("kept")
+	logger.Error("kept")
+
+	if len(low.records) != 1 {
+		t.Errorf("low: got %d records, want 1", len(low.records))
+	}
+	if len(errs.records) != 1 {
+		t.Errorf("errs: got %d records, want 1", len(errs.records))
+	}
+}
+
+func BenchmarkFilter_Allowed(b *testing.B) {
+	f := NewFilter(discardHandler{}, AllowInfoAndAbove())
+	logger := slog.New(f)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		logger.Info("benchmark message", "i", i)
+	}
+}
+
+func BenchmarkFilter_Dropped(b *testing.B) {
+	f := NewFilter(discardHandler{}, AllowErrorOnly())
+	logger := slog.New(f)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		logger.Info("benchmark message", "i", i)
+	}
+}
+
+func BenchmarkUnfiltered(b *testing.B) {
+	logger := slog.New(discardHandler{})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		logger.Info("benchmark message", "i", i)
+	}
+}
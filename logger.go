@@ -2,18 +2,41 @@ package slogx
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"log/slog"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/lmittmann/tint"
 )
 
+// LevelTrace and LevelFatal extend slog's four standard levels so LOG_LEVEL
+// can select finer-grained verbosity or a level that terminates the process.
+const (
+	LevelTrace = slog.Level(-8)
+	LevelFatal = slog.Level(12)
+)
+
+// ANSI color codes passed to tint.Attr for level labels.
+const (
+	colorGreen  = 2
+	colorYellow = 3
+	colorRed    = 9
+)
+
 // LevelBasedHandler routes log records to different handlers based on level:
-// - Info, Debug, Warn -> LowLevelHandler (no source, lighter output)
-// - Error and above   -> ErrorHandler (with source, highlighted errors)
+// - Trace, Debug, Info, Warn -> LowLevelHandler (no source, lighter output)
+// - Error and Fatal          -> ErrorHandler (with source, highlighted errors)
 type LevelBasedHandler struct {
 	LowLevelHandler slog.Handler
 	ErrorHandler    slog.Handler
+
+	// Level is the shared threshold used by both inner handlers, if they
+	// were built by New/NewWithConfig. It is nil for handlers assembled by
+	// hand. Use the package-level LevelVar helper to retrieve it.
+	Level *slog.LevelVar
 }
 
 func (h *LevelBasedHandler) Enabled(ctx context.Context, level slog.Level) bool {
@@ -34,6 +57,7 @@ func (h *LevelBasedHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
 	return &LevelBasedHandler{
 		LowLevelHandler: h.LowLevelHandler.WithAttrs(attrs),
 		ErrorHandler:    h.ErrorHandler.WithAttrs(attrs),
+		Level:           h.Level,
 	}
 }
 
@@ -41,9 +65,46 @@ func (h *LevelBasedHandler) WithGroup(name string) slog.Handler {
 	return &LevelBasedHandler{
 		LowLevelHandler: h.LowLevelHandler.WithGroup(name),
 		ErrorHandler:    h.ErrorHandler.WithGroup(name),
+		Level:           h.Level,
 	}
 }
 
+// Format selects the output encoding used by a logger built via
+// NewWithConfig.
+type Format int
+
+const (
+	// FormatTint renders colored, human-readable output (default).
+	FormatTint Format = iota
+	// FormatJSON renders each record as a single JSON object.
+	FormatJSON
+	// FormatText renders each record as logfmt-style key=value pairs.
+	FormatText
+)
+
+// Config controls how NewWithConfig builds a logger's pair of handlers.
+type Config struct {
+	// Format selects the output encoding. Defaults to FormatTint.
+	Format Format
+	// Level is the initial threshold, shared by both handlers via a
+	// *slog.LevelVar. Defaults to slog.LevelInfo. Use LevelVar to change the
+	// threshold at runtime regardless of what is supplied here.
+	Level slog.Leveler
+	// LowWriter receives Trace/Debug/Info/Warn records. Defaults to os.Stderr.
+	LowWriter io.Writer
+	// ErrorWriter receives Error/Fatal records. Defaults to os.Stderr.
+	ErrorWriter io.Writer
+	// AddSourceOnError adds the source file/line to Error/Fatal records.
+	// The low-level handler never adds source.
+	AddSourceOnError bool
+	// TintOptions supplies additional tint.Options when Format is FormatTint.
+	// Its Level and AddSource fields are overridden by the other Config
+	// fields above. Its ReplaceAttr, if set, runs first and is then wrapped
+	// by slogx's level-label (and, on the error handler, red err/error)
+	// rewriting.
+	TintOptions *tint.Options
+}
+
 // New returns a slog.Logger that:
 // - uses a tint handler without source for Info/Debug/Warn
 // - uses a tint handler with source (and red-colored "err"/"error" fields) for Error+
@@ -54,38 +115,162 @@ func (h *LevelBasedHandler) WithGroup(name string) slog.Handler {
 //   - low-level handler:  AddSource is forced to false
 //   - error handler:      AddSource is forced to true and its ReplaceAttr is
 //     wrapped to also color "err"/"error" attributes red.
+//
+// New is a thin wrapper around NewWithConfig using Format: FormatTint.
 func New(opts ...*tint.Options) *slog.Logger {
-	// Start from zero-value options, or from the user-provided base options.
-	var baseOpts tint.Options
-	if len(opts) > 0 && opts[0] != nil {
-		baseOpts = *opts[0]
-	}
-
-	// Low-level handler: same as base, but without source.
-	lowOpts := baseOpts
-	lowOpts.AddSource = false
-	lowLevelHandler := tint.NewHandler(os.Stderr, &lowOpts)
-
-	// Error handler: same as base, but with source and enhanced ReplaceAttr.
-	errOpts := baseOpts
-	errOpts.AddSource = true
-	userReplace := errOpts.ReplaceAttr
-	errOpts.ReplaceAttr = func(groups []string, a slog.Attr) slog.Attr {
-		// Let the user-supplied ReplaceAttr run first, if present.
-		if userReplace != nil {
-			a = userReplace(groups, a)
+	var tintOpts *tint.Options
+	if len(opts) > 0 {
+		tintOpts = opts[0]
+	}
+
+	var level slog.Leveler
+	if tintOpts != nil {
+		level = tintOpts.Level
+	}
+
+	return NewWithConfig(Config{
+		Format:           FormatTint,
+		Level:            level,
+		AddSourceOnError: true,
+		TintOptions:      tintOpts,
+	})
+}
+
+// Option configures a Config built by NewWithOptions. It composes with the
+// writer/source/level fields NewWithConfig already exposes.
+type Option func(*Config)
+
+// WithErrorWriter sends Error/Fatal records to w instead of os.Stderr.
+func WithErrorWriter(w io.Writer) Option {
+	return func(c *Config) { c.ErrorWriter = w }
+}
+
+// WithLowWriter sends Trace/Debug/Info/Warn records to w instead of
+// os.Stderr.
+func WithLowWriter(w io.Writer) Option {
+	return func(c *Config) { c.LowWriter = w }
+}
+
+// NewWithOptions is like New, but also accepts Options for concerns
+// NewWithConfig exposes beyond a single tint.Options value, such as sending
+// error records to a distinct io.Writer (e.g. a file or syslog) while
+// keeping colored non-error logs on stderr.
+func NewWithOptions(tintOpts *tint.Options, opts ...Option) *slog.Logger {
+	cfg := Config{
+		Format:           FormatTint,
+		AddSourceOnError: true,
+		TintOptions:      tintOpts,
+	}
+	if tintOpts != nil {
+		cfg.Level = tintOpts.Level
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return NewWithConfig(cfg)
+}
+
+// OpenErrorFile opens path in append mode, creating it if necessary
+// (mode 0644), for use as an error sink with WithErrorWriter or
+// Config.ErrorWriter. The caller is responsible for closing it.
+func OpenErrorFile(path string) (io.WriteCloser, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("slogx: open error file: %w", err)
+	}
+	return f, nil
+}
+
+// NewWithConfig returns a slog.Logger built from cfg: a LevelBasedHandler
+// whose two inner handlers are encoded per cfg.Format, write to
+// cfg.LowWriter/cfg.ErrorWriter, and share a single *slog.LevelVar seeded
+// from cfg.Level.
+func NewWithConfig(cfg Config) *slog.Logger {
+	lowWriter := cfg.LowWriter
+	if lowWriter == nil {
+		lowWriter = os.Stderr
+	}
+	errWriter := cfg.ErrorWriter
+	if errWriter == nil {
+		errWriter = os.Stderr
+	}
+
+	// Both inner handlers share a single LevelVar so the threshold can be
+	// changed at runtime (see LevelVar) without rebuilding either handler.
+	levelVar := &slog.LevelVar{}
+	if cfg.Level != nil {
+		levelVar.Set(cfg.Level.Level())
+	}
+
+	var lowHandler, errorHandler slog.Handler
+	switch cfg.Format {
+	case FormatJSON, FormatText:
+		lowHandlerOpts := &slog.HandlerOptions{
+			Level:       levelVar,
+			ReplaceAttr: replaceLevelLabelPlain,
 		}
-		// Then color errors red.
-		if a.Key == "err" || a.Key == "error" {
-			a = tint.Attr(9, a)
+		errHandlerOpts := &slog.HandlerOptions{
+			AddSource:   cfg.AddSourceOnError,
+			Level:       levelVar,
+			ReplaceAttr: replaceLevelLabelPlain,
 		}
-		return a
+
+		if cfg.Format == FormatJSON {
+			lowHandler = slog.NewJSONHandler(lowWriter, lowHandlerOpts)
+			errorHandler = slog.NewJSONHandler(errWriter, errHandlerOpts)
+		} else {
+			lowHandler = slog.NewTextHandler(lowWriter, lowHandlerOpts)
+			errorHandler = slog.NewTextHandler(errWriter, errHandlerOpts)
+		}
+
+		// Error records get a synthetic "severity":"error" attribute so
+		// downstream log ingesters (Loki, Cloud Logging) can filter on it,
+		// replacing the red coloring tint provides.
+		errorHandler = errorHandler.WithAttrs([]slog.Attr{slog.String("severity", "error")})
+
+	default: // FormatTint
+		var baseOpts tint.Options
+		if cfg.TintOptions != nil {
+			baseOpts = *cfg.TintOptions
+		}
+
+		// Low-level handler: same as base, but without source.
+		lowOpts := baseOpts
+		lowOpts.AddSource = false
+		lowOpts.Level = levelVar
+		lowUserReplace := lowOpts.ReplaceAttr
+		lowOpts.ReplaceAttr = func(groups []string, a slog.Attr) slog.Attr {
+			if lowUserReplace != nil {
+				a = lowUserReplace(groups, a)
+			}
+			return replaceLevelLabel(groups, a)
+		}
+		lowHandler = tint.NewHandler(lowWriter, &lowOpts)
+
+		// Error handler: same as base, but with source and enhanced ReplaceAttr.
+		errOpts := baseOpts
+		errOpts.AddSource = cfg.AddSourceOnError
+		errOpts.Level = levelVar
+		userReplace := errOpts.ReplaceAttr
+		errOpts.ReplaceAttr = func(groups []string, a slog.Attr) slog.Attr {
+			// Let the user-supplied ReplaceAttr run first, if present.
+			if userReplace != nil {
+				a = userReplace(groups, a)
+			}
+			a = replaceLevelLabel(groups, a)
+			// Then color errors red.
+			if a.Key == "err" || a.Key == "error" {
+				a = tint.Attr(colorRed, a)
+			}
+			return a
+		}
+		errorHandler = tint.NewHandler(errWriter, &errOpts)
 	}
-	errorHandler := tint.NewHandler(os.Stderr, &errOpts)
 
 	return slog.New(&LevelBasedHandler{
-		LowLevelHandler: lowLevelHandler,
+		LowLevelHandler: lowHandler,
 		ErrorHandler:    errorHandler,
+		Level:           levelVar,
 	})
 }
 
@@ -96,3 +281,123 @@ func InitDefault(opts ...*tint.Options) *slog.Logger {
 	slog.SetDefault(logger)
 	return logger
 }
+
+// Trace logs at LevelTrace on the default logger.
+func Trace(msg string, args ...any) {
+	slog.Default().Log(context.Background(), LevelTrace, msg, args...)
+}
+
+// Fatal logs at LevelFatal on the default logger and then terminates the
+// process via os.Exit(1). Deferred calls will not run.
+func Fatal(msg string, args ...any) {
+	slog.Default().Log(context.Background(), LevelFatal, msg, args...)
+	os.Exit(1)
+}
+
+// InitDefaultFromEnv behaves like InitDefault, but additionally reads the
+// LOG_LEVEL environment variable and applies it to the logger's shared
+// LevelVar, overriding any level set via opts. Recognized values are
+// "trace", "debug", "info", "warn", "error", "fatal" (case-insensitive) or
+// a numeric slog level such as "-8" or "4". An empty or unrecognized value
+// leaves the level untouched.
+func InitDefaultFromEnv(opts ...*tint.Options) *slog.Logger {
+	logger := InitDefault(opts...)
+	if lvl, ok := parseLevelEnv(os.Getenv("LOG_LEVEL")); ok {
+		if lv := LevelVar(logger); lv != nil {
+			lv.Set(lvl)
+		}
+	}
+	return logger
+}
+
+// LevelVar returns the *slog.LevelVar shared by the two inner handlers of a
+// logger built by New/InitDefault/InitDefaultFromEnv, allowing the log level
+// to be changed at runtime, e.g. LevelVar(logger).Set(slog.LevelDebug). It
+// returns nil if logger's handler is not a *LevelBasedHandler with a Level
+// set.
+func LevelVar(logger *slog.Logger) *slog.LevelVar {
+	h, ok := logger.Handler().(*LevelBasedHandler)
+	if !ok {
+		return nil
+	}
+	return h.Level
+}
+
+// parseLevelEnv parses a LOG_LEVEL value into a slog.Level. It accepts the
+// named levels (case-insensitive) "trace", "debug", "info", "warn", "error",
+// "fatal", as well as plain numeric levels like "-8" or "4".
+func parseLevelEnv(raw string) (slog.Level, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return 0, false
+	}
+	switch strings.ToLower(raw) {
+	case "trace":
+		return LevelTrace, true
+	case "debug":
+		return slog.LevelDebug, true
+	case "info":
+		return slog.LevelInfo, true
+	case "warn", "warning":
+		return slog.LevelWarn, true
+	case "error":
+		return slog.LevelError, true
+	case "fatal":
+		return LevelFatal, true
+	}
+	if n, err := strconv.Atoi(raw); err == nil {
+		return slog.Level(n), true
+	}
+	return 0, false
+}
+
+// levelLabel returns the TRACE/DEBUG/INFO/WARN/ERROR/FATAL string label and
+// tint color (yellow for TRACE/DEBUG/WARN, green for INFO, red for
+// ERROR/FATAL) for level.
+func levelLabel(level slog.Level) (label string, color uint8) {
+	switch {
+	case level < slog.LevelDebug:
+		return "TRACE", colorYellow
+	case level < slog.LevelInfo:
+		return "DEBUG", colorYellow
+	case level < slog.LevelWarn:
+		return "INFO", colorGreen
+	case level < slog.LevelError:
+		return "WARN", colorYellow
+	case level < LevelFatal:
+		return "ERROR", colorRed
+	default:
+		return "FATAL", colorRed
+	}
+}
+
+// replaceLevelLabel rewrites the slog.LevelKey attr into its string label and
+// colors it using tint.Attr. For use with tint.Options.ReplaceAttr.
+func replaceLevelLabel(_ []string, a slog.Attr) slog.Attr {
+	if a.Key != slog.LevelKey {
+		return a
+	}
+	level, ok := a.Value.Any().(slog.Level)
+	if !ok {
+		return a
+	}
+	label, color := levelLabel(level)
+	a.Value = slog.StringValue(label)
+	return tint.Attr(color, a)
+}
+
+// replaceLevelLabelPlain rewrites the slog.LevelKey attr into its string
+// label without any coloring. For use with slog.HandlerOptions.ReplaceAttr
+// (JSON/text formats).
+func replaceLevelLabelPlain(_ []string, a slog.Attr) slog.Attr {
+	if a.Key != slog.LevelKey {
+		return a
+	}
+	level, ok := a.Value.Any().(slog.Level)
+	if !ok {
+		return a
+	}
+	label, _ := levelLabel(level)
+	a.Value = slog.StringValue(label)
+	return a
+}
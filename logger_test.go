@@ -1,8 +1,13 @@
 package slogx
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -95,6 +100,20 @@ func TestLevelBasedHandler_RoutesByLevel(t *testing.T) {
 			expectInLow:   0,
 			expectInError: 1,
 		},
+		{
+			name:          "trace routes to low",
+			logLevel:      LevelTrace,
+			logMessage:    "trace message",
+			expectInLow:   1,
+			expectInError: 0,
+		},
+		{
+			name:          "fatal routes to error handler",
+			logLevel:      LevelFatal,
+			logMessage:    "fatal message",
+			expectInLow:   0,
+			expectInError: 1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -283,6 +302,269 @@ func TestNew_WithOptions_ReplaceAttrCalled(t *testing.T) {
 	}
 }
 
+func TestLevelVar_SharedBetweenHandlers(t *testing.T) {
+	logger := New()
+
+	lv := LevelVar(logger)
+	if lv == nil {
+		t.Fatal("LevelVar() returned nil for a logger built by New")
+	}
+
+	if lv.Level() != slog.LevelInfo {
+		t.Errorf("initial level = %v, want %v", lv.Level(), slog.LevelInfo)
+	}
+
+	lv.Set(slog.LevelDebug)
+
+	h, ok := logger.Handler().(*LevelBasedHandler)
+	if !ok {
+		t.Fatalf("Handler() = %T, want *LevelBasedHandler", logger.Handler())
+	}
+	if !h.Enabled(context.Background(), slog.LevelDebug) {
+		t.Error("expected LowLevelHandler to become enabled for debug after LevelVar.Set")
+	}
+}
+
+func TestLevelVar_RespectsInitialOptionsLevel(t *testing.T) {
+	logger := New(&tint.Options{Level: slog.LevelWarn})
+
+	lv := LevelVar(logger)
+	if lv == nil {
+		t.Fatal("LevelVar() returned nil")
+	}
+	if lv.Level() != slog.LevelWarn {
+		t.Errorf("initial level = %v, want %v", lv.Level(), slog.LevelWarn)
+	}
+}
+
+func TestParseLevelEnv(t *testing.T) {
+	tests := []struct {
+		raw    string
+		want   slog.Level
+		wantOk bool
+	}{
+		{raw: "trace", want: LevelTrace, wantOk: true},
+		{raw: "DEBUG", want: slog.LevelDebug, wantOk: true},
+		{raw: "info", want: slog.LevelInfo, wantOk: true},
+		{raw: "Warn", want: slog.LevelWarn, wantOk: true},
+		{raw: "error", want: slog.LevelError, wantOk: true},
+		{raw: "fatal", want: LevelFatal, wantOk: true},
+		{raw: "-8", want: slog.LevelDebug - 4, wantOk: true},
+		{raw: "4", want: slog.LevelWarn, wantOk: true},
+		{raw: "", want: 0, wantOk: false},
+		{raw: "bogus", want: 0, wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, ok := parseLevelEnv(tt.raw)
+			if ok != tt.wantOk || got != tt.want {
+				t.Errorf("parseLevelEnv(%q) = (%v, %v), want (%v, %v)", tt.raw, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestInitDefaultFromEnv(t *testing.T) {
+	t.Setenv("LOG_LEVEL", "debug")
+
+	logger := InitDefaultFromEnv()
+
+	lv := LevelVar(logger)
+	if lv == nil {
+		t.Fatal("LevelVar() returned nil")
+	}
+	if lv.Level() != slog.LevelDebug {
+		t.Errorf("level = %v, want %v", lv.Level(), slog.LevelDebug)
+	}
+}
+
+func TestReplaceLevelLabel(t *testing.T) {
+	tests := []struct {
+		name  string
+		level slog.Level
+		want  string
+	}{
+		{name: "trace", level: LevelTrace, want: "TRACE"},
+		{name: "debug", level: slog.LevelDebug, want: "DEBUG"},
+		{name: "info", level: slog.LevelInfo, want: "INFO"},
+		{name: "warn", level: slog.LevelWarn, want: "WARN"},
+		{name: "error", level: slog.LevelError, want: "ERROR"},
+		{name: "fatal", level: LevelFatal, want: "FATAL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := replaceLevelLabel(nil, slog.Any(slog.LevelKey, tt.level))
+			if got := a.Value.String(); got != tt.want {
+				t.Errorf("replaceLevelLabel(%v) label = %q, want %q", tt.level, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplaceLevelLabel_IgnoresOtherKeys(t *testing.T) {
+	in := slog.String("msg", "hello")
+	if got := replaceLevelLabel(nil, in); got.Key != in.Key || got.Value.String() != in.Value.String() {
+		t.Errorf("replaceLevelLabel() modified non-level attr: got %+v, want %+v", got, in)
+	}
+}
+
+func TestTrace_LogsThroughDefault(t *testing.T) {
+	low := &stubHandler{}
+	errs := &stubHandler{}
+	slog.SetDefault(slog.New(&LevelBasedHandler{LowLevelHandler: low, ErrorHandler: errs}))
+
+	Trace("trace message")
+
+	if len(low.records) != 1 {
+		t.Fatalf("expected 1 record in low handler, got %d", len(low.records))
+	}
+	if low.records[0].Level != LevelTrace {
+		t.Errorf("level = %v, want %v", low.records[0].Level, LevelTrace)
+	}
+}
+
+func TestNewWithConfig_JSON(t *testing.T) {
+	var low, errs bytes.Buffer
+
+	logger := NewWithConfig(Config{
+		Format:      FormatJSON,
+		LowWriter:   &low,
+		ErrorWriter: &errs,
+	})
+
+	logger.Info("info message")
+	logger.Error("error message")
+
+	var infoEntry map[string]any
+	if err := json.Unmarshal(low.Bytes(), &infoEntry); err != nil {
+		t.Fatalf("low writer did not contain valid JSON: %v", err)
+	}
+	if infoEntry["level"] != "INFO" {
+		t.Errorf("level = %v, want INFO", infoEntry["level"])
+	}
+
+	var errEntry map[string]any
+	if err := json.Unmarshal(errs.Bytes(), &errEntry); err != nil {
+		t.Fatalf("error writer did not contain valid JSON: %v", err)
+	}
+	if errEntry["level"] != "ERROR" {
+		t.Errorf("level = %v, want ERROR", errEntry["level"])
+	}
+	if errEntry["severity"] != "error" {
+		t.Errorf("severity = %v, want \"error\"", errEntry["severity"])
+	}
+	if _, ok := infoEntry["severity"]; ok {
+		t.Error("low writer entry should not have a severity attribute")
+	}
+}
+
+func TestNewWithConfig_Text(t *testing.T) {
+	var low, errs bytes.Buffer
+
+	logger := NewWithConfig(Config{
+		Format:      FormatText,
+		LowWriter:   &low,
+		ErrorWriter: &errs,
+	})
+
+	logger.Warn("warn message")
+	logger.Error("error message")
+
+	if !strings.Contains(low.String(), "level=WARN") {
+		t.Errorf("low output = %q, want it to contain level=WARN", low.String())
+	}
+	if !strings.Contains(errs.String(), "severity=error") {
+		t.Errorf("error output = %q, want it to contain severity=error", errs.String())
+	}
+}
+
+func TestNewWithConfig_SourceOnErrorOnly(t *testing.T) {
+	var low, errs bytes.Buffer
+
+	logger := NewWithConfig(Config{
+		Format:           FormatJSON,
+		LowWriter:        &low,
+		ErrorWriter:      &errs,
+		AddSourceOnError: true,
+	})
+
+	logger.Info("info message")
+	logger.Error("error message")
+
+	if strings.Contains(low.String(), `"source"`) {
+		t.Error("low writer should not include source")
+	}
+	if !strings.Contains(errs.String(), `"source"`) {
+		t.Error("error writer should include source")
+	}
+}
+
+func TestNew_StillUsesTintFormat(t *testing.T) {
+	logger := New(&tint.Options{NoColor: true})
+	if logger == nil {
+		t.Fatal("New() returned nil")
+	}
+	h, ok := logger.Handler().(*LevelBasedHandler)
+	if !ok {
+		t.Fatalf("Handler() = %T, want *LevelBasedHandler", logger.Handler())
+	}
+	for _, inner := range []slog.Handler{h.LowLevelHandler, h.ErrorHandler} {
+		switch inner.(type) {
+		case *slog.JSONHandler, *slog.TextHandler:
+			t.Errorf("inner handler = %T, want a tint handler", inner)
+		}
+	}
+}
+
+func TestNewWithOptions_SplitsWriters(t *testing.T) {
+	var low, errs bytes.Buffer
+
+	logger := NewWithOptions(&tint.Options{NoColor: true},
+		WithLowWriter(&low),
+		WithErrorWriter(&errs),
+	)
+
+	logger.Info("info message")
+	logger.Error("error message")
+
+	if !strings.Contains(low.String(), "info message") {
+		t.Errorf("low writer = %q, want it to contain the info message", low.String())
+	}
+	if strings.Contains(low.String(), "error message") {
+		t.Error("low writer should not contain error-level records")
+	}
+	if !strings.Contains(errs.String(), "error message") {
+		t.Errorf("error writer = %q, want it to contain the error message", errs.String())
+	}
+	if strings.Contains(errs.String(), "info message") {
+		t.Error("error writer should not contain low-level records")
+	}
+}
+
+func TestOpenErrorFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "errors.log")
+
+	f, err := OpenErrorFile(path)
+	if err != nil {
+		t.Fatalf("OpenErrorFile() error = %v", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write([]byte("boom\n")); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if string(data) != "boom\n" {
+		t.Errorf("file contents = %q, want %q", data, "boom\n")
+	}
+}
+
 func TestInitDefault(t *testing.T) {
 	tests := []struct {
 		name string
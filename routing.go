@@ -0,0 +1,93 @@
+package slogx
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+)
+
+// LevelMax is a convenience upper bound for a Route that should match every
+// level from Min upward (e.g. an "ERROR and above" route).
+const LevelMax = slog.Level(math.MaxInt)
+
+// RoutingMode controls how RoutingHandler dispatches a record when more than
+// one Route matches its level.
+type RoutingMode int
+
+const (
+	// FirstMatch dispatches a record to only the first matching Route, in
+	// the order Routes are listed. This is the default.
+	FirstMatch RoutingMode = iota
+	// AllMatches dispatches a record to every matching Route.
+	AllMatches
+)
+
+// Route pairs a [Min, Max] level range (inclusive) with the Handler that
+// should receive records in that range.
+type Route struct {
+	Min, Max slog.Level
+	Handler  slog.Handler
+}
+
+func (r Route) matches(level slog.Level) bool {
+	return level >= r.Min && level <= r.Max
+}
+
+// RoutingHandler dispatches records to an ordered list of Routes based on
+// level, generalizing LevelBasedHandler's fixed two-way split into an
+// arbitrary number of destinations and level ranges. For example: DEBUG to a
+// file, INFO and WARN to a stderr tint handler, ERROR and above to both
+// stderr and a webhook/syslog handler (Mode: AllMatches).
+type RoutingHandler struct {
+	Routes []Route
+	Mode   RoutingMode
+}
+
+func (h *RoutingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, route := range h.Routes {
+		if route.matches(level) && route.Handler.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *RoutingHandler) Handle(ctx context.Context, r slog.Record) error {
+	var errs []error
+	for _, route := range h.Routes {
+		if !route.matches(r.Level) {
+			continue
+		}
+		if err := route.Handler.Handle(ctx, r.Clone()); err != nil {
+			if h.Mode == FirstMatch {
+				return err
+			}
+			// In AllMatches mode, a failing route (e.g. a flaky webhook)
+			// must not stop the record from reaching the remaining routes
+			// (e.g. a reliable stderr sink).
+			errs = append(errs, err)
+			continue
+		}
+		if h.Mode == FirstMatch {
+			return nil
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (h *RoutingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	routes := make([]Route, len(h.Routes))
+	for i, route := range h.Routes {
+		routes[i] = Route{Min: route.Min, Max: route.Max, Handler: route.Handler.WithAttrs(attrs)}
+	}
+	return &RoutingHandler{Routes: routes, Mode: h.Mode}
+}
+
+func (h *RoutingHandler) WithGroup(name string) slog.Handler {
+	routes := make([]Route, len(h.Routes))
+	for i, route := range h.Routes {
+		routes[i] = Route{Min: route.Min, Max: route.Max, Handler: route.Handler.WithGroup(name)}
+	}
+	return &RoutingHandler{Routes: routes, Mode: h.Mode}
+}
@@ -0,0 +1,191 @@
+package slogx
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestRoutingHandler_FirstMatch(t *testing.T) {
+	debugFile := &stubHandler{}
+	stderr := &stubHandler{}
+	webhook := &stubHandler{}
+
+	h := &RoutingHandler{
+		Mode: FirstMatch,
+		Routes: []Route{
+			{Min: slog.LevelDebug, Max: slog.LevelDebug, Handler: debugFile},
+			{Min: slog.LevelInfo, Max: slog.LevelWarn, Handler: stderr},
+			{Min: slog.LevelError, Max: LevelMax, Handler: webhook},
+		},
+	}
+
+	ctx := context.Background()
+	for _, lvl := range []slog.Level{slog.LevelDebug, slog.LevelInfo, slog.LevelWarn, slog.LevelError} {
+		rec := slog.NewRecord(time.Now(), lvl, "msg", 0)
+		if err := h.Handle(ctx, rec); err != nil {
+			t.Fatalf("Handle(%v) error = %v", lvl, err)
+		}
+	}
+
+	if len(debugFile.records) != 1 {
+		t.Errorf("debugFile: got %d records, want 1", len(debugFile.records))
+	}
+	if len(stderr.records) != 2 {
+		t.Errorf("stderr: got %d records, want 2", len(stderr.records))
+	}
+	if len(webhook.records) != 1 {
+		t.Errorf("webhook: got %d records, want 1", len(webhook.records))
+	}
+}
+
+func TestRoutingHandler_AllMatches(t *testing.T) {
+	stderr := &stubHandler{}
+	webhook := &stubHandler{}
+
+	h := &RoutingHandler{
+		Mode: AllMatches,
+		Routes: []Route{
+			{Min: slog.LevelError, Max: LevelMax, Handler: stderr},
+			{Min: slog.LevelError, Max: LevelMax, Handler: webhook},
+		},
+	}
+
+	rec := slog.NewRecord(time.Now(), slog.LevelError, "boom", 0)
+	if err := h.Handle(context.Background(), rec); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+
+	if len(stderr.records) != 1 {
+		t.Errorf("stderr: got %d records, want 1", len(stderr.records))
+	}
+	if len(webhook.records) != 1 {
+		t.Errorf("webhook: got %d records, want 1", len(webhook.records))
+	}
+}
+
+type erroringHandler struct {
+	err error
+}
+
+func (h *erroringHandler) Enabled(context.Context, slog.Level) bool  { return true }
+func (h *erroringHandler) Handle(context.Context, slog.Record) error { return h.err }
+func (h *erroringHandler) WithAttrs([]slog.Attr) slog.Handler        { return h }
+func (h *erroringHandler) WithGroup(string) slog.Handler             { return h }
+
+func TestRoutingHandler_AllMatches_ContinuesPastError(t *testing.T) {
+	failing := &erroringHandler{err: errors.New("webhook timeout")}
+	stderr := &stubHandler{}
+
+	h := &RoutingHandler{
+		Mode: AllMatches,
+		Routes: []Route{
+			{Min: slog.LevelError, Max: LevelMax, Handler: failing},
+			{Min: slog.LevelError, Max: LevelMax, Handler: stderr},
+		},
+	}
+
+	rec := slog.NewRecord(time.Now(), slog.LevelError, "boom", 0)
+	err := h.Handle(context.Background(), rec)
+
+	if len(stderr.records) != 1 {
+		t.Errorf("stderr: got %d records, want 1 (a failing route should not block later routes)", len(stderr.records))
+	}
+	if err == nil {
+		t.Error("expected Handle() to report the failing route's error")
+	}
+}
+
+func TestRoutingHandler_Enabled(t *testing.T) {
+	low := &levelFilterHandler{minLevel: slog.LevelDebug}
+	high := &levelFilterHandler{minLevel: slog.LevelError}
+
+	h := &RoutingHandler{
+		Routes: []Route{
+			{Min: slog.LevelDebug, Max: slog.LevelWarn, Handler: low},
+			{Min: slog.LevelError, Max: LevelMax, Handler: high},
+		},
+	}
+
+	ctx := context.Background()
+	if !h.Enabled(ctx, slog.LevelInfo) {
+		t.Error("expected Enabled(Info) to be true")
+	}
+	if !h.Enabled(ctx, slog.LevelError) {
+		t.Error("expected Enabled(Error) to be true")
+	}
+}
+
+func TestRoutingHandler_NoMatchingRoute(t *testing.T) {
+	stderr := &stubHandler{}
+
+	h := &RoutingHandler{
+		Routes: []Route{
+			{Min: slog.LevelError, Max: LevelMax, Handler: stderr},
+		},
+	}
+
+	rec := slog.NewRecord(time.Now(), slog.LevelInfo, "msg", 0)
+	if err := h.Handle(context.Background(), rec); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	if len(stderr.records) != 0 {
+		t.Errorf("stderr: got %d records, want 0", len(stderr.records))
+	}
+	if h.Enabled(context.Background(), slog.LevelInfo) {
+		t.Error("expected Enabled(Info) to be false when no route matches")
+	}
+}
+
+// recordingHandler tracks the attrs/group it was asked to add, returning a
+// distinct handler each time so tests can tell WithAttrs/WithGroup actually
+// propagated (unlike stubHandler, whose WithAttrs/WithGroup are no-ops that
+// return the same pointer).
+type recordingHandler struct {
+	attrs []slog.Attr
+	group string
+}
+
+func (h *recordingHandler) Enabled(context.Context, slog.Level) bool  { return true }
+func (h *recordingHandler) Handle(context.Context, slog.Record) error { return nil }
+
+func (h *recordingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &recordingHandler{attrs: append(append([]slog.Attr{}, h.attrs...), attrs...), group: h.group}
+}
+
+func (h *recordingHandler) WithGroup(name string) slog.Handler {
+	return &recordingHandler{attrs: h.attrs, group: name}
+}
+
+func TestRoutingHandler_WithAttrsAndWithGroup(t *testing.T) {
+	inner := &recordingHandler{}
+	h := &RoutingHandler{
+		Routes: []Route{{Min: slog.LevelDebug, Max: LevelMax, Handler: inner}},
+	}
+
+	withAttrs, ok := h.WithAttrs([]slog.Attr{slog.String("k", "v")}).(*RoutingHandler)
+	if !ok {
+		t.Fatal("WithAttrs() did not return *RoutingHandler")
+	}
+	if len(withAttrs.Routes) != 1 {
+		t.Fatalf("WithAttrs() routes = %d, want 1", len(withAttrs.Routes))
+	}
+	got, ok := withAttrs.Routes[0].Handler.(*recordingHandler)
+	if !ok || len(got.attrs) != 1 || got.attrs[0].Key != "k" {
+		t.Errorf("WithAttrs() did not propagate to the route's handler, got %+v", got)
+	}
+
+	withGroup, ok := h.WithGroup("g").(*RoutingHandler)
+	if !ok {
+		t.Fatal("WithGroup() did not return *RoutingHandler")
+	}
+	if len(withGroup.Routes) != 1 {
+		t.Fatalf("WithGroup() routes = %d, want 1", len(withGroup.Routes))
+	}
+	got, ok = withGroup.Routes[0].Handler.(*recordingHandler)
+	if !ok || got.group != "g" {
+		t.Errorf("WithGroup() did not propagate to the route's handler, got %+v", got)
+	}
+}
@@ -0,0 +1,24 @@
+//go:build unix
+
+package slogx
+
+import (
+	"fmt"
+	"log/slog"
+	"log/syslog"
+)
+
+// NewSyslogErrorHandler returns a slog.Handler that writes Error/Fatal
+// records to the local syslog/journald daemon under tag, for use as
+// LevelBasedHandler.ErrorHandler or a Route's Handler when operators want
+// errors shipped to syslog while non-error logs stay on stderr.
+func NewSyslogErrorHandler(tag string) (slog.Handler, error) {
+	w, err := syslog.New(syslog.LOG_ERR, tag)
+	if err != nil {
+		return nil, fmt.Errorf("slogx: new syslog writer: %w", err)
+	}
+	return slog.NewTextHandler(w, &slog.HandlerOptions{
+		AddSource:   true,
+		ReplaceAttr: replaceLevelLabelPlain,
+	}), nil
+}